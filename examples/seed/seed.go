@@ -80,26 +80,36 @@ func ClearDatabase(ctx context.Context, db typedb.Executor) error {
 	// Note: MSSQL requires TRUNCATE to be on its own line, and it doesn't work with foreign keys
 	// So we'll use DELETE for all databases for consistency
 
-	// Try to delete posts (ignore error if table doesn't exist)
-	if _, err := db.Exec(ctx, "DELETE FROM posts"); err != nil {
-		// Check if error is about table not existing - if so, that's okay
-		errStr := strings.ToLower(err.Error())
-		if !strings.Contains(errStr, "invalid object name") && !strings.Contains(errStr, "does not exist") && !strings.Contains(errStr, "no such table") {
-			return fmt.Errorf("failed to delete posts: %w", err)
-		}
-	}
-	if _, err := db.Exec(ctx, "DELETE FROM profiles"); err != nil {
-		errStr := strings.ToLower(err.Error())
-		if !strings.Contains(errStr, "invalid object name") && !strings.Contains(errStr, "does not exist") && !strings.Contains(errStr, "no such table") {
-			return fmt.Errorf("failed to delete profiles: %w", err)
-		}
-	}
-	if _, err := db.Exec(ctx, "DELETE FROM users"); err != nil {
-		errStr := strings.ToLower(err.Error())
-		if !strings.Contains(errStr, "invalid object name") && !strings.Contains(errStr, "does not exist") && !strings.Contains(errStr, "no such table") {
-			return fmt.Errorf("failed to delete users: %w", err)
+	// Try to delete each table (ignore error if table doesn't exist)
+	for _, table := range []string{"posts", "profiles", "users"} {
+		if _, err := db.Exec(ctx, "DELETE FROM "+table); err != nil && !isMissingTableError(err) {
+			return fmt.Errorf("failed to delete %s: %w", table, err)
 		}
 	}
 	fmt.Println("✓ Database cleared")
 	return nil
 }
+
+// missingTableErrors are the lowercased fragments each driver puts in its
+// "table does not exist" error. Error codes are used where the driver includes
+// them in the message.
+var missingTableErrors = []string{
+	"error 1146",          // MySQL: Error 1146 (42S02): Table '...' doesn't exist
+	"ora-00942",           // Oracle: ORA-00942: table or view does not exist
+	"invalid object name", // SQL Server: mssql: Invalid object name '...' (error 208)
+	"does not exist",      // PostgreSQL: pq: relation "..." does not exist (42P01)
+	"no such table",       // SQLite: no such table: ...
+}
+
+// isMissingTableError reports whether err is a driver's "table does not exist" error.
+// typedb v1.0.12 returns Exec errors from the driver unchanged and has no typed
+// errors for them, so the message is matched against missingTableErrors.
+func isMissingTableError(err error) bool {
+	errStr := strings.ToLower(err.Error())
+	for _, fragment := range missingTableErrors {
+		if strings.Contains(errStr, fragment) {
+			return true
+		}
+	}
+	return false
+}
@@ -50,7 +50,7 @@ func (p *Post) TableName() string {
 }
 
 func (p *Post) QueryByID() string {
-	return "SELECT id, user_id, title, content, tags, metadata, created_at FROM posts WHERE id = @p1"
+	return "SELECT id, user_id, title, content, tags, metadata, created_at, updated_at FROM posts WHERE id = @p1"
 }
 
 func (p *Post) QueryByUserID() string {
@@ -314,6 +314,11 @@ func TestMySQL_Update_NonPartialUpdate(t *testing.T) {
 		t.Errorf("Expected content to remain unchanged '%s', got '%s'", originalLoadedContent, updatedPost.Content)
 	}
 
+	// Verify updated_at was set by the auto-timestamp field and read back by QueryByID
+	if updatedPost.UpdatedAt == "" {
+		t.Error("UpdatedAt should be populated after update")
+	}
+
 	// Restore original content
 	restorePost := &Post{
 		ID:      firstPost.ID,
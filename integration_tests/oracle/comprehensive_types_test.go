@@ -128,6 +128,11 @@ func TestOracle_ComprehensiveTypesRoundTrip(t *testing.T) {
 		db.Exec(ctx, "DELETE FROM type_examples WHERE id = :1", testID)
 	}()
 
+	// UROWID can't be bound from Go, so store the row's own ROWID in it
+	if _, err := db.Exec(ctx, "UPDATE type_examples SET urowid_col = ROWID WHERE id = :1", testID); err != nil {
+		t.Fatalf("Failed to set urowid_col: %v", err)
+	}
+
 	// Query it back
 	loaded := &TypeExample{ID: testID}
 	if err := typedb.Load(ctx, db, loaded); err != nil {
@@ -208,6 +213,9 @@ func TestOracle_ComprehensiveTypesRoundTrip(t *testing.T) {
 	if loaded.IntervalDayCol == "" {
 		t.Error("IntervalDayCol: should not be empty")
 	}
+	if loaded.UrowidCol == "" {
+		t.Error("UrowidCol: should not be empty")
+	}
 	if loaded.XmltypeCol == "" {
 		t.Error("XmltypeCol: should not be empty")
 	}
@@ -297,4 +305,20 @@ func TestOracle_LongRawType(t *testing.T) {
 			break
 		}
 	}
+	// Update writes only long_raw_col; the table has no updated_at column for an auto-timestamp
+	// Oracle converts the hex string to LONG RAW, as HEXTORAW does for the insert above
+	updateHexValue := "CAFEBABE"
+	if err := typedb.Update(ctx, db, &LongRawExample{ID: testID, LongRawCol: updateHexValue}); err != nil {
+		t.Fatalf("Failed to update LONG RAW data: %v", err)
+	}
+
+	updated := &LongRawExample{ID: testID}
+	if err := typedb.Load(ctx, db, updated); err != nil {
+		t.Fatalf("Failed to load updated LONG RAW data: %v", err)
+	}
+
+	updatedHex := strings.ToUpper(hex.EncodeToString([]byte(updated.LongRawCol)))
+	if updatedHex != updateHexValue {
+		t.Errorf("LongRawCol after update: expected hex '%s', got hex '%s'", updateHexValue, updatedHex)
+	}
 }
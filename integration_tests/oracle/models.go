@@ -108,6 +108,7 @@ type TypeExample struct {
 	FloatPrecisionCol     string `db:"float_precision_col"`
 	TimestampPrecisionCol string `db:"timestamp_precision_col"`
 	RowidCol              string `db:"rowid_col"`
+	UrowidCol             string `db:"urowid_col"`
 	DateCol               string `db:"date_col"`
 	TimestampCol          string `db:"timestamp_col"`
 	TimestampTzCol        string `db:"timestamp_tz_col"`
@@ -133,7 +134,6 @@ type LongRawExample struct {
 	ID         int    `db:"id" load:"primary"`
 	LongRawCol string `db:"long_raw_col"`
 	CreatedAt  string `db:"created_at"`
-	UpdatedAt  string `db:"updated_at" dbUpdate:"auto-timestamp"`
 }
 
 func (l *LongRawExample) TableName() string {
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS audited_notes;
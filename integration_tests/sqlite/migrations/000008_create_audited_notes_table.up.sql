@@ -0,0 +1,8 @@
+-- Table for testing models that embed a shared audit column group
+CREATE TABLE IF NOT EXISTS audited_notes (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    title TEXT NOT NULL,
+    body TEXT,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME
+);
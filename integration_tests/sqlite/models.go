@@ -50,7 +50,7 @@ func (p *Post) TableName() string {
 }
 
 func (p *Post) QueryByID() string {
-	return "SELECT id, user_id, title, content, tags, metadata, created_at FROM posts WHERE id = ?"
+	return "SELECT id, user_id, title, content, tags, metadata, created_at, updated_at FROM posts WHERE id = ?"
 }
 
 func (p *Post) QueryByUserID() string {
@@ -133,3 +133,30 @@ func init() {
 	typedb.RegisterModel[*ZeroValueTest]()
 	typedb.RegisterModelWithOptions[*ZeroValueTest](typedb.ModelOptions{PartialUpdate: true})
 }
+
+// Audit is a column group shared by embedding it anonymously in a model
+type Audit struct {
+	CreatedAt string `db:"created_at"`
+	UpdatedAt string `db:"updated_at" dbUpdate:"auto-timestamp"`
+}
+
+// AuditedNote is for testing models whose timestamp columns come from an embedded struct
+type AuditedNote struct {
+	typedb.Model
+	ID    int    `db:"id" load:"primary"`
+	Title string `db:"title"`
+	Body  string `db:"body"`
+	Audit
+}
+
+func (n *AuditedNote) TableName() string {
+	return "audited_notes"
+}
+
+func (n *AuditedNote) QueryByID() string {
+	return "SELECT id, title, body, created_at, updated_at FROM audited_notes WHERE id = ?"
+}
+
+func init() {
+	typedb.RegisterModelWithOptions[*AuditedNote](typedb.ModelOptions{PartialUpdate: true})
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -224,6 +225,43 @@ func TestSQLite_RegistrationValidation_RegisterModelWithOptions_InvalidModel(t *
 	}
 }
 
+func TestSQLite_RegistrationValidation_ValidationError(t *testing.T) {
+	// Reset registry for isolated test
+	typedb.ResetValidation()
+
+	// ValidateModel reports the same problem without panicking
+	err := typedb.ValidateModel(&InvalidRegistrationModelMissingQueryBy{})
+
+	var validationErr *typedb.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Expected *typedb.ValidationError for model missing QueryByID method, got: %v", err)
+	}
+
+	if validationErr.ModelName != "InvalidRegistrationModelMissingQueryBy" {
+		t.Errorf("Expected ModelName 'InvalidRegistrationModelMissingQueryBy', got: %s", validationErr.ModelName)
+	}
+
+	if len(validationErr.Errors) != 1 || !strings.Contains(validationErr.Errors[0], "QueryByID") {
+		t.Errorf("Expected one validation error mentioning QueryByID, got: %v", validationErr.Errors)
+	}
+
+	// The RegisterModel panic value wraps the same *typedb.ValidationError
+	var panicErr error
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				panicErr, _ = r.(error)
+			}
+		}()
+
+		typedb.RegisterModel[*InvalidRegistrationModelMissingQueryBy]()
+	}()
+
+	if !errors.As(panicErr, &validationErr) {
+		t.Errorf("Expected RegisterModel to panic with an error wrapping *typedb.ValidationError, got: %v", panicErr)
+	}
+}
+
 func TestSQLite_RegistrationValidation_ValidModelCanBeUsed(t *testing.T) {
 	db := setupTestDB(t)
 	defer closeDB(t, db)
@@ -285,6 +285,11 @@ func TestSQLite_Update_NonPartialUpdate(t *testing.T) {
 		t.Errorf("Expected content to remain unchanged '%s', got '%s'", originalLoadedContent, updatedPost.Content)
 	}
 
+	// Verify updated_at was set by the auto-timestamp field and read back by QueryByID
+	if updatedPost.UpdatedAt == "" {
+		t.Error("UpdatedAt should be populated after update")
+	}
+
 	// Restore original content
 	restorePost := &Post{
 		ID:      firstPost.ID,
@@ -398,3 +403,68 @@ func TestSQLite_Update_PartialUpdate_ZeroValues(t *testing.T) {
 		}
 	}
 }
+
+// TestSQLite_Update_EmbeddedStruct verifies that columns declared on an anonymously
+// embedded struct are written on insert, filled on load, and honored by partial update.
+func TestSQLite_Update_EmbeddedStruct(t *testing.T) {
+	db := setupTestDB(t)
+	defer closeDB(t, db)
+	defer os.Remove(getTestDSN())
+
+	ctx := context.Background()
+
+	note := &AuditedNote{Title: "Embedded", Body: "Audit columns come from an embedded struct"}
+	if err := typedb.Insert(ctx, db, note); err != nil {
+		t.Fatalf("Insert failed: %v", err)
+	}
+	if note.ID == 0 {
+		t.Fatal("AuditedNote ID should be set after insert")
+	}
+
+	// Load fills the embedded columns: created_at from the column default, updated_at still NULL
+	loaded := &AuditedNote{ID: note.ID}
+	if err := typedb.Load(ctx, db, loaded); err != nil {
+		t.Fatalf("Failed to load inserted note: %v", err)
+	}
+	if loaded.CreatedAt == "" {
+		t.Error("CreatedAt should be loaded through the embedded Audit struct")
+	}
+	if loaded.UpdatedAt != "" {
+		t.Errorf("UpdatedAt should be empty before any update, got %q", loaded.UpdatedAt)
+	}
+	createdAt := loaded.CreatedAt
+
+	// Partial update after Load writes only the changed column
+	loaded.Title = "Embedded (edited)"
+	if err := typedb.Update(ctx, db, loaded); err != nil {
+		t.Fatalf("Partial update failed: %v", err)
+	}
+	afterPartial := &AuditedNote{ID: note.ID}
+	if err := typedb.Load(ctx, db, afterPartial); err != nil {
+		t.Fatalf("Failed to load note after partial update: %v", err)
+	}
+	if afterPartial.Title != "Embedded (edited)" {
+		t.Errorf("Expected title 'Embedded (edited)', got '%s'", afterPartial.Title)
+	}
+	if afterPartial.CreatedAt != createdAt {
+		t.Errorf("CreatedAt should be unchanged by partial update: expected %q, got %q", createdAt, afterPartial.CreatedAt)
+	}
+
+	// Update without a prior Load has no original copy, so it includes the embedded auto-timestamp column
+	if err := typedb.Update(ctx, db, &AuditedNote{ID: note.ID, Body: "Updated body"}); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	afterUpdate := &AuditedNote{ID: note.ID}
+	if err := typedb.Load(ctx, db, afterUpdate); err != nil {
+		t.Fatalf("Failed to load note after update: %v", err)
+	}
+	if afterUpdate.Body != "Updated body" {
+		t.Errorf("Expected body 'Updated body', got '%s'", afterUpdate.Body)
+	}
+	if afterUpdate.UpdatedAt == "" {
+		t.Error("UpdatedAt should be set by the embedded auto-timestamp field")
+	}
+	if afterUpdate.CreatedAt != createdAt {
+		t.Errorf("CreatedAt should be unchanged by update: expected %q, got %q", createdAt, afterUpdate.CreatedAt)
+	}
+}